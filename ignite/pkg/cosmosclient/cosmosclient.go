@@ -25,11 +25,13 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
@@ -48,6 +50,10 @@ var (
 	// ErrInvalidBlockHeight is returned when a block height value is not valid.
 	ErrInvalidBlockHeight = errors.New("block height must be greater than 0")
 
+	// ErrFeeGrantRejected is returned when a transaction is rejected because
+	// the fee granter doesn't allow paying its fees.
+	ErrFeeGrantRejected = errors.New("fee grant rejected")
+
 	errCannotRetrieveFundsFromFaucet = errors.New("cannot retrieve funds from faucet")
 )
 
@@ -59,6 +65,14 @@ const (
 	defaultGasAdjustment = 1.0
 	defaultGasLimit      = 300000
 
+	// simulatedGasMargin is added to the simulated gas because it can
+	// vary from the actual gas needed for a real transaction.
+	simulatedGasMargin = 20000
+
+	// gasAdjustmentStep is the gas adjustment increase applied
+	// each time a tx is broadcasted again after running out of gas.
+	gasAdjustmentStep = 0.5
+
 	defaultFaucetAddress   = "http://localhost:4500"
 	defaultFaucetDenom     = "token"
 	defaultFaucetMinAmount = 100
@@ -127,11 +141,13 @@ type Client struct {
 	keyringBackend     cosmosaccount.KeyringBackend
 	keyringDir         string
 
-	gas           string
-	gasPrices     string
-	gasAdjustment float64
-	fees          string
-	generateOnly  bool
+	gas              string
+	gasPrices        string
+	gasAdjustment    float64
+	maxGasAdjustment float64
+	fees             string
+	feeGranter       string
+	generateOnly     bool
 }
 
 // Option configures your client.
@@ -217,6 +233,15 @@ func WithGasAdjustment(gasAdjustment float64) Option {
 	}
 }
 
+// WithMaxGasAdjustment enables txs that run out of gas to be simulated again
+// and broadcasted again with an increased gas adjustment, until the gas
+// adjustment reaches maxGasAdjustment.
+func WithMaxGasAdjustment(maxGasAdjustment float64) Option {
+	return func(c *Client) {
+		c.maxGasAdjustment = maxGasAdjustment
+	}
+}
+
 // WithFees sets the fees (e.g. 10uatom).
 func WithFees(fees string) Option {
 	return func(c *Client) {
@@ -224,6 +249,14 @@ func WithFees(fees string) Option {
 	}
 }
 
+// WithFeeGranter sets the address of the account paying the fees of the txs
+// through a fee grant (e.g. cosmos1...), instead of the signer.
+func WithFeeGranter(address string) Option {
+	return func(c *Client) {
+		c.feeGranter = address
+	}
+}
+
 // WithGenerateOnly tells if txs will be generated only.
 func WithGenerateOnly(generateOnly bool) Option {
 	return func(c *Client) {
@@ -567,6 +600,14 @@ func (c Client) CreateTx(goCtx context.Context, account cosmosaccount.Account, m
 		WithFromName(account.Name).
 		WithFromAddress(sdkaddr)
 
+	if c.feeGranter != "" {
+		granter, err := sdktypes.AccAddressFromBech32(c.feeGranter)
+		if err != nil {
+			return TxService{}, errors.Wrap(err, "invalid fee granter address")
+		}
+		ctx = ctx.WithFeeGranterAddress(granter)
+	}
+
 	txf, err := c.prepareFactory(ctx)
 	if err != nil {
 		return TxService{}, err
@@ -585,7 +626,7 @@ func (c Client) CreateTx(goCtx context.Context, account cosmosaccount.Account, m
 		}
 		// the simulated gas can vary from the actual gas needed for a real transaction
 		// we add an amount to ensure sufficient gas is provided
-		gas += 20000
+		gas += simulatedGasMargin
 	}
 	txf = txf.WithGas(gas)
 	txf = txf.WithFees(c.fees)
@@ -742,7 +783,8 @@ func (c *Client) checkAccountBalance(ctx context.Context, address string) error
 }
 
 // handleBroadcastResult handles the result of broadcast messages result and checks if an error occurred.
-func handleBroadcastResult(resp *sdktypes.TxResponse, err error) error {
+// feeGranted tells if the fees of the tx are paid by a fee granter.
+func handleBroadcastResult(resp *sdktypes.TxResponse, err error, feeGranted bool) error {
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			return errors.New("make sure that your account has enough balance")
@@ -751,11 +793,33 @@ func handleBroadcastResult(resp *sdktypes.TxResponse, err error) error {
 	}
 
 	if resp.Code > 0 {
+		if feeGranted && isFeeGrantRejection(resp) {
+			return errors.Wrapf(ErrFeeGrantRejected, "error code: '%d' msg: '%s'", resp.Code, resp.RawLog)
+		}
+		if resp.Codespace == sdkerrors.RootCodespace && resp.Code == sdkerrors.ErrOutOfGas.ABCICode() {
+			return errors.Wrapf(sdkerrors.ErrOutOfGas, "error code: '%d' msg: '%s'", resp.Code, resp.RawLog)
+		}
 		return errors.Errorf("error code: '%d' msg: '%s'", resp.Code, resp.RawLog)
 	}
 	return nil
 }
 
+// isFeeGrantRejection checks if a tx has been rejected because its fee granter
+// doesn't pay its fees.
+func isFeeGrantRejection(resp *sdktypes.TxResponse) bool {
+	switch resp.Codespace {
+	case feegrant.ModuleName:
+		return true
+	case sdkerrors.RootCodespace:
+		// The fee grant keeper returns a not found error when the granter never granted
+		// an allowance, and the ante handler returns an invalid request error when the
+		// chain doesn't have the fee grant module.
+		return (resp.Code == sdkerrors.ErrNotFound.ABCICode() && strings.Contains(resp.RawLog, "fee-grant not found")) ||
+			(resp.Code == sdkerrors.ErrInvalidRequest.ABCICode() && strings.Contains(resp.RawLog, "fee grants are not enabled"))
+	}
+	return false
+}
+
 func (c *Client) prepareFactory(clientCtx client.Context) (tx.Factory, error) {
 	var (
 		from = clientCtx.GetFromAddress()
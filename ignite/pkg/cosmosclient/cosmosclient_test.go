@@ -493,6 +493,37 @@ func TestClientCreateTx(t *testing.T) {
 				s.expectPrepareFactory(sdkaddr)
 			},
 		},
+		{
+			name: "ok: with fee granter",
+			opts: []cosmosclient.Option{
+				cosmosclient.WithFeeGranter(sdkaddr.String()),
+			},
+			msg: &banktypes.MsgSend{
+				FromAddress: "from",
+				ToAddress:   "to",
+				Amount: sdktypes.NewCoins(
+					sdktypes.NewCoin("token", sdktypes.NewIntFromUint64(1)),
+				),
+			},
+			expectedJSONTx: fmt.Sprintf(`{"body":{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"from","to_address":"to","amount":[{"denom":"token","amount":"1"}]}],"memo":"","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[],"fee":{"amount":[],"gas_limit":"300000","payer":"","granter":"%s"},"tip":null},"signatures":[]}`, sdkaddr),
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddr)
+			},
+		},
+		{
+			name: "fail: with invalid fee granter",
+			opts: []cosmosclient.Option{
+				cosmosclient.WithFeeGranter("invalid"),
+			},
+			msg: &banktypes.MsgSend{
+				FromAddress: "from",
+				ToAddress:   "to",
+				Amount: sdktypes.NewCoins(
+					sdktypes.NewCoin("token", sdktypes.NewIntFromUint64(1)),
+				),
+			},
+			expectedError: "invalid fee granter address: decoding bech32 failed: invalid bech32 string length 7",
+		},
 		{
 			name: "ok: with gas price",
 			opts: []cosmosclient.Option{
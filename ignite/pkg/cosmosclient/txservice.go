@@ -2,10 +2,12 @@ package cosmosclient
 
 import (
 	"context"
+	"math"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/pkg/errors"
 )
 
@@ -27,6 +29,9 @@ func (s TxService) Gas() uint64 {
 // it automatically filled with the default amount, and the tx is broadcasted
 // again. Note that this may still end with the same error if the amount is
 // greater than the amount dumped by the faucet.
+// If a max gas adjustment is configured and the tx runs out of gas, the gas
+// is simulated again with an increased gas adjustment and the tx is broadcasted
+// again, until the max gas adjustment is reached.
 func (s TxService) Broadcast(ctx context.Context) (Response, error) {
 	defer s.client.lockBech32Prefix()()
 
@@ -37,6 +42,18 @@ func (s TxService) Broadcast(ctx context.Context) (Response, error) {
 		}
 	}
 
+	res, err := s.broadcast(ctx)
+	for errors.Is(err, sdkerrors.ErrOutOfGas) && s.txFactory.GasAdjustment() < s.client.maxGasAdjustment {
+		if s, err = s.increaseGas(); err != nil {
+			return Response{}, err
+		}
+		res, err = s.broadcast(ctx)
+	}
+
+	return res, err
+}
+
+func (s TxService) broadcast(ctx context.Context) (Response, error) {
 	accountName := s.clientContext.GetFromName()
 	if err := s.client.signer.Sign(s.txFactory, accountName, s.txBuilder, true); err != nil {
 		return Response{}, errors.WithStack(err)
@@ -47,8 +64,10 @@ func (s TxService) Broadcast(ctx context.Context) (Response, error) {
 		return Response{}, errors.WithStack(err)
 	}
 
+	feeGranted := len(s.txBuilder.GetTx().FeeGranter()) > 0
+
 	resp, err := s.clientContext.BroadcastTx(txBytes)
-	if err := handleBroadcastResult(resp, err); err != nil {
+	if err := handleBroadcastResult(resp, err, feeGranted); err != nil {
 		return Response{}, err
 	}
 
@@ -68,7 +87,47 @@ func (s TxService) Broadcast(ctx context.Context) (Response, error) {
 	return Response{
 		Codec:      s.clientContext.Codec,
 		TxResponse: resp,
-	}, handleBroadcastResult(resp, err)
+	}, handleBroadcastResult(resp, err, feeGranted)
+}
+
+// increaseGas returns a tx service for the same messages with an increased gas
+// adjustment, the gas simulated again and the account sequence refreshed,
+// since a tx that ran out of gas during its execution consumes its sequence.
+func (s TxService) increaseGas() (TxService, error) {
+	var (
+		msgs          = s.txBuilder.GetTx().GetMsgs()
+		feeGranter    = s.txBuilder.GetTx().FeeGranter()
+		gasAdjustment = math.Min(s.txFactory.GasAdjustment()+gasAdjustmentStep, s.client.maxGasAdjustment)
+	)
+
+	_, seq, err := s.client.accountRetriever.GetAccountNumberSequence(s.clientContext, s.clientContext.GetFromAddress())
+	if err != nil {
+		return TxService{}, errors.WithStack(err)
+	}
+
+	txf := s.txFactory.
+		WithSequence(seq).
+		WithGasAdjustment(gasAdjustment)
+
+	_, gas, err := s.client.gasometer.CalculateGas(s.clientContext, txf, msgs...)
+	if err != nil {
+		return TxService{}, errors.WithStack(err)
+	}
+	txf = txf.WithGas(gas + simulatedGasMargin)
+
+	txUnsigned, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return TxService{}, errors.WithStack(err)
+	}
+
+	txUnsigned.SetFeeGranter(feeGranter)
+
+	return TxService{
+		client:        s.client,
+		clientContext: s.clientContext,
+		txBuilder:     txUnsigned,
+		txFactory:     txf,
+	}, nil
 }
 
 // EncodeJSON encodes the transaction as a json string.
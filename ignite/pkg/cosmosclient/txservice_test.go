@@ -7,6 +7,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -26,6 +27,7 @@ func TestTxServiceBroadcast(t *testing.T) {
 		passphrase  = "passphrase"
 		txHash      = []byte{1, 2, 3}
 		txHashStr   = hex.EncodeToString(txHash)
+		granter     = "cosmos1k8e50d2d8xkdfw9c4et3m45llh69e7xzw6uzga"
 	)
 	r, err := cosmosaccount.NewInMemory()
 	require.NoError(t, err)
@@ -49,6 +51,8 @@ func TestTxServiceBroadcast(t *testing.T) {
 		opts             []cosmosclient.Option
 		expectedResponse *sdktypes.TxResponse
 		expectedError    string
+		expectedErrorIs  error
+		expectedErrorNot error
 		setup            func(suite)
 	}{
 		{
@@ -92,6 +96,240 @@ func TestTxServiceBroadcast(t *testing.T) {
 					}, nil)
 			},
 		},
+		{
+			name:            "fail: fee grant not found",
+			msg:             msg,
+			opts:            []cosmosclient.Option{cosmosclient.WithFeeGranter(granter)},
+			expectedError:   "error code: '38' msg: 'fee-grant not found: not found': fee grant rejected",
+			expectedErrorIs: cosmosclient.ErrFeeGrantRejected,
+
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddr)
+				s.signer.EXPECT().
+					Sign(mock.Anything, "bob", mock.Anything, true).
+					Return(nil)
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(&ctypes.ResultBroadcastTx{
+						Code:      sdkerrors.ErrNotFound.ABCICode(),
+						Codespace: sdkerrors.RootCodespace,
+						Log:       "fee-grant not found: not found",
+					}, nil)
+			},
+		},
+		{
+			name:            "fail: fee grants not enabled",
+			msg:             msg,
+			opts:            []cosmosclient.Option{cosmosclient.WithFeeGranter(granter)},
+			expectedError:   "error code: '18' msg: 'fee grants are not enabled: invalid request': fee grant rejected",
+			expectedErrorIs: cosmosclient.ErrFeeGrantRejected,
+
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddr)
+				s.signer.EXPECT().
+					Sign(mock.Anything, "bob", mock.Anything, true).
+					Return(nil)
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(&ctypes.ResultBroadcastTx{
+						Code:      sdkerrors.ErrInvalidRequest.ABCICode(),
+						Codespace: sdkerrors.RootCodespace,
+						Log:       "fee grants are not enabled: invalid request",
+					}, nil)
+			},
+		},
+		{
+			name:            "fail: tx confirmed with fee grant error code",
+			msg:             msg,
+			opts:            []cosmosclient.Option{cosmosclient.WithFeeGranter(granter)},
+			expectedError:   "error code: '2' msg: 'fee limit exceeded': fee grant rejected",
+			expectedErrorIs: cosmosclient.ErrFeeGrantRejected,
+
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddr)
+				s.signer.EXPECT().
+					Sign(mock.Anything, "bob", mock.Anything, true).
+					Return(nil)
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(&ctypes.ResultBroadcastTx{
+						Hash: txHash,
+					}, nil)
+
+				// Tx is broadcasted, now check for confirmation
+				s.rpcClient.EXPECT().Tx(goCtx, txHash, false).
+					Return(&ctypes.ResultTx{
+						Hash: txHash,
+						TxResult: abci.ResponseDeliverTx{
+							Code:      2,
+							Codespace: "feegrant",
+							Log:       "fee limit exceeded",
+						},
+					}, nil)
+			},
+		},
+		{
+			name:             "fail: tx confirmed with unrelated not found error code and fee granter",
+			msg:              msg,
+			opts:             []cosmosclient.Option{cosmosclient.WithFeeGranter(granter)},
+			expectedError:    "error code: '38' msg: 'denom metadata not found: not found'",
+			expectedErrorNot: cosmosclient.ErrFeeGrantRejected,
+
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddr)
+				s.signer.EXPECT().
+					Sign(mock.Anything, "bob", mock.Anything, true).
+					Return(nil)
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(&ctypes.ResultBroadcastTx{
+						Hash: txHash,
+					}, nil)
+
+				// Tx is broadcasted, now check for confirmation
+				s.rpcClient.EXPECT().Tx(goCtx, txHash, false).
+					Return(&ctypes.ResultTx{
+						Hash: txHash,
+						TxResult: abci.ResponseDeliverTx{
+							Code:      sdkerrors.ErrNotFound.ABCICode(),
+							Codespace: sdkerrors.RootCodespace,
+							Log:       "denom metadata not found: not found",
+						},
+					}, nil)
+			},
+		},
+		{
+			name:          "fail: not found error code without fee granter",
+			msg:           msg,
+			expectedError: "error code: '38' msg: 'not found'",
+
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddr)
+				s.signer.EXPECT().
+					Sign(mock.Anything, "bob", mock.Anything, true).
+					Return(nil)
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(&ctypes.ResultBroadcastTx{
+						Code:      sdkerrors.ErrNotFound.ABCICode(),
+						Codespace: sdkerrors.RootCodespace,
+						Log:       "not found",
+					}, nil)
+			},
+		},
+		{
+			name: "ok: out of gas, broadcasted again with a higher gas adjustment",
+			msg:  msg,
+			opts: []cosmosclient.Option{cosmosclient.WithMaxGasAdjustment(2)},
+			expectedResponse: &sdktypes.TxResponse{
+				TxHash: txHashStr,
+				RawLog: "log",
+			},
+
+			setup: func(s suite) {
+				s.accountRetriever.EXPECT().
+					EnsureExists(mock.Anything, sdkaddr).
+					Return(nil)
+				s.accountRetriever.EXPECT().
+					GetAccountNumberSequence(mock.Anything, sdkaddr).
+					Return(1, 2, nil).Once()
+				s.signer.EXPECT().
+					Sign(mock.MatchedBy(func(txf tx.Factory) bool {
+						return txf.Sequence() == 2
+					}), "bob", mock.Anything, true).
+					Return(nil).Once()
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(&ctypes.ResultBroadcastTx{
+						Code:      sdkerrors.ErrOutOfGas.ABCICode(),
+						Codespace: sdkerrors.RootCodespace,
+						Log:       "out of gas",
+					}, nil).Once()
+
+				// The sequence is refreshed and the gas is simulated again
+				// with an increased gas adjustment
+				s.accountRetriever.EXPECT().
+					GetAccountNumberSequence(mock.Anything, sdkaddr).
+					Return(1, 3, nil).Once()
+				s.gasometer.EXPECT().
+					CalculateGas(mock.Anything, mock.MatchedBy(func(txf tx.Factory) bool {
+						return txf.GasAdjustment() == 1.5 && txf.Sequence() == 3
+					}), mock.Anything).
+					Return(nil, 42, nil).Once()
+				s.signer.EXPECT().
+					Sign(mock.MatchedBy(func(txf tx.Factory) bool {
+						return txf.Sequence() == 3 && txf.Gas() == 42+20000
+					}), "bob", mock.Anything, true).
+					Return(nil).Once()
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(&ctypes.ResultBroadcastTx{
+						Hash: txHash,
+					}, nil).Once()
+
+				// Tx is broadcasted, now check for confirmation
+				s.rpcClient.EXPECT().Tx(goCtx, txHash, false).
+					Return(&ctypes.ResultTx{
+						Hash: txHash,
+						TxResult: abci.ResponseDeliverTx{
+							Log: "log",
+						},
+					}, nil)
+			},
+		},
+		{
+			name:            "fail: out of gas, max gas adjustment reached",
+			msg:             msg,
+			opts:            []cosmosclient.Option{cosmosclient.WithMaxGasAdjustment(1.5)},
+			expectedError:   "error code: '11' msg: 'out of gas': out of gas",
+			expectedErrorIs: sdkerrors.ErrOutOfGas,
+
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddr)
+				s.signer.EXPECT().
+					Sign(mock.Anything, "bob", mock.Anything, true).
+					Return(nil)
+				s.gasometer.EXPECT().
+					CalculateGas(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, 42, nil).Once()
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(&ctypes.ResultBroadcastTx{
+						Code:      sdkerrors.ErrOutOfGas.ABCICode(),
+						Codespace: sdkerrors.RootCodespace,
+						Log:       "out of gas",
+					}, nil).Times(2)
+			},
+		},
+		{
+			name:            "fail: tx confirmed out of gas without max gas adjustment",
+			msg:             msg,
+			expectedError:   "error code: '11' msg: 'out of gas': out of gas",
+			expectedErrorIs: sdkerrors.ErrOutOfGas,
+
+			setup: func(s suite) {
+				s.expectPrepareFactory(sdkaddr)
+				s.signer.EXPECT().
+					Sign(mock.Anything, "bob", mock.Anything, true).
+					Return(nil)
+				s.rpcClient.EXPECT().
+					BroadcastTxSync(mock.Anything, mock.Anything).
+					Return(&ctypes.ResultBroadcastTx{
+						Hash: txHash,
+					}, nil).Once()
+
+				// Tx is broadcasted, now check for confirmation
+				s.rpcClient.EXPECT().Tx(goCtx, txHash, false).
+					Return(&ctypes.ResultTx{
+						Hash: txHash,
+						TxResult: abci.ResponseDeliverTx{
+							Code:      sdkerrors.ErrOutOfGas.ABCICode(),
+							Codespace: sdkerrors.RootCodespace,
+							Log:       "out of gas",
+						},
+					}, nil)
+			},
+		},
 		{
 			name: "ok: tx confirmed immediately",
 			msg:  msg,
@@ -202,6 +440,12 @@ func TestTxServiceBroadcast(t *testing.T) {
 
 			if tt.expectedError != "" {
 				require.EqualError(t, err, tt.expectedError)
+				if tt.expectedErrorIs != nil {
+					require.ErrorIs(t, err, tt.expectedErrorIs)
+				}
+				if tt.expectedErrorNot != nil {
+					require.NotErrorIs(t, err, tt.expectedErrorNot)
+				}
 				return
 			}
 			require.NoError(t, err)
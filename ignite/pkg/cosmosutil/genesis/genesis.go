@@ -14,6 +14,7 @@ const (
 	fieldPathStakeDenom = "app_state.staking.params.bond_denom"
 	fieldPathChainID    = "chain_id"
 	fieldPathAccounts   = "app_state.auth.accounts"
+	fieldPathBalances   = "app_state.bank.balances"
 	fieldPathGentxs     = "app_state.genutil.gen_txs"
 
 	FieldGenesisTime                 = "genesis_time"
@@ -34,7 +35,7 @@ type (
 	Genesis struct {
 		*jsonfile.JSONFile
 	}
-	accounts []struct {
+	addresses []struct {
 		Address string `json:"address"`
 	}
 	gentxs []struct{}
@@ -77,15 +78,18 @@ func CheckGenesisContainsAddress(genesisPath, addr string) (bool, error) {
 	return genesis.HasAccount(addr), nil
 }
 
-// HasAccount check if account exist into the genesis account.
+// HasAccount check if account exist into the genesis auth accounts or bank balances.
+// Some genesis exports only list the account balances, without any auth account.
 func (g Genesis) HasAccount(address string) bool {
-	accounts, err := g.Accounts()
-	if err != nil {
-		return false
-	}
-	for _, account := range accounts {
-		if account == address {
-			return true
+	for _, getAddresses := range []func() ([]string, error){g.Accounts, g.BalanceAddresses} {
+		addresses, err := getAddresses()
+		if err != nil {
+			continue
+		}
+		for _, addr := range addresses {
+			if addr == address {
+				return true
+			}
 		}
 	}
 	return false
@@ -105,7 +109,7 @@ func (g *Genesis) ChainID() (chainID string, err error) {
 
 // Accounts returns the auth accounts from the genesis.
 func (g *Genesis) Accounts() ([]string, error) {
-	var accs accounts
+	var accs addresses
 	err := g.Field(fieldPathAccounts, &accs)
 	accountList := make([]string, len(accs))
 	for i, acc := range accs {
@@ -114,6 +118,17 @@ func (g *Genesis) Accounts() ([]string, error) {
 	return accountList, err
 }
 
+// BalanceAddresses returns the addresses of the bank balances from the genesis.
+func (g *Genesis) BalanceAddresses() ([]string, error) {
+	var bals addresses
+	err := g.Field(fieldPathBalances, &bals)
+	addressList := make([]string, len(bals))
+	for i, bal := range bals {
+		addressList[i] = bal.Address
+	}
+	return addressList, err
+}
+
 // GentxCount returns the number of gentx in the genesis.
 func (g *Genesis) GentxCount() (int, error) {
 	var gentxs gentxs
@@ -36,3 +36,45 @@ func TestModuleParamField(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckGenesisContainsAddress(t *testing.T) {
+	tests := []struct {
+		name        string
+		genesisPath string
+		address     string
+		want        bool
+	}{
+		{
+			name:        "auth account",
+			genesisPath: "testdata/genesis_auth.json",
+			address:     "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+			want:        true,
+		},
+		{
+			name:        "bank balance without auth account",
+			genesisPath: "testdata/genesis_bank.json",
+			address:     "cosmos1mmlqwyqk7neqegffp99q86eckpm4pjah3ytlpa",
+			want:        true,
+		},
+		{
+			name:        "missing address",
+			genesisPath: "testdata/genesis_auth.json",
+			address:     "cosmos1mmlqwyqk7neqegffp99q86eckpm4pjah3ytlpa",
+			want:        false,
+		},
+		{
+			name:        "not found file",
+			genesisPath: "testdata/genesis_not_found.json",
+			address:     "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+			want:        false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := cosmosgenesis.CheckGenesisContainsAddress(tc.genesisPath, tc.address)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
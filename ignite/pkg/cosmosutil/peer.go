@@ -0,0 +1,78 @@
+package cosmosutil
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"strings"
+
+	"github.com/cometbft/cometbft/p2p"
+
+	"github.com/ignite/cli/ignite/pkg/confile"
+)
+
+const (
+	nodeKeyFilename    = "node_key.json"
+	configTOMLFilename = "config.toml"
+)
+
+type (
+	// PeerOption configures the peer returned by PeerFromNodeConfig.
+	PeerOption func(*peerOptions)
+
+	peerOptions struct {
+		externalAddress string
+	}
+)
+
+// WithPeerExternalAddress overrides the p2p external address (host:port)
+// read from the node's config.toml.
+func WithPeerExternalAddress(address string) PeerOption {
+	return func(o *peerOptions) {
+		o.externalAddress = address
+	}
+}
+
+// PeerFromNodeConfig returns the peer (nodeID@host:port) of the node using chainHome as home.
+// The node ID is read from the node key and the address from the p2p external address
+// of the node config, unless an override is provided.
+func PeerFromNodeConfig(chainHome string, options ...PeerOption) (string, error) {
+	var o peerOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	configDir := filepath.Join(chainHome, ChainConfigDir)
+
+	nodeKey, err := p2p.LoadNodeKey(filepath.Join(configDir, nodeKeyFilename))
+	if err != nil {
+		return "", fmt.Errorf("cannot read the node key: %w", err)
+	}
+
+	if o.externalAddress == "" {
+		var conf struct {
+			P2P struct {
+				ExternalAddress string `toml:"external_address"`
+			} `toml:"p2p"`
+		}
+		cf := confile.New(confile.DefaultTOMLEncodingCreator, filepath.Join(configDir, configTOMLFilename))
+		if err := cf.Load(&conf); err != nil {
+			return "", fmt.Errorf("cannot read the node config: %w", err)
+		}
+		if conf.P2P.ExternalAddress == "" {
+			return "", fmt.Errorf("the p2p external address is not set in %s", configTOMLFilename)
+		}
+		o.externalAddress = conf.P2P.ExternalAddress
+	}
+
+	address := strings.TrimPrefix(o.externalAddress, "tcp://")
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", fmt.Errorf("invalid p2p external address %q: %w", o.externalAddress, err)
+	}
+	if host == "" {
+		return "", fmt.Errorf("invalid p2p external address %q: missing host", o.externalAddress)
+	}
+
+	return fmt.Sprintf("%s@%s", nodeKey.ID(), address), nil
+}
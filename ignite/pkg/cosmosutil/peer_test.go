@@ -0,0 +1,86 @@
+package cosmosutil_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+func TestPeerFromNodeConfig(t *testing.T) {
+	tests := []struct {
+		name            string
+		externalAddress string
+		options         []cosmosutil.PeerOption
+		withoutNodeKey  bool
+		wantAddress     string
+		wantErr         bool
+	}{
+		{
+			name:            "external address from config",
+			externalAddress: "tcp://192.168.0.148:26656",
+			wantAddress:     "192.168.0.148:26656",
+		},
+		{
+			name:            "external address override",
+			externalAddress: "192.168.0.148:26656",
+			options:         []cosmosutil.PeerOption{cosmosutil.WithPeerExternalAddress("10.0.0.1:26656")},
+			wantAddress:     "10.0.0.1:26656",
+		},
+		{
+			name:    "empty external address",
+			wantErr: true,
+		},
+		{
+			name:            "invalid external address",
+			externalAddress: "192.168.0.148",
+			wantErr:         true,
+		},
+		{
+			name:            "external address without host",
+			externalAddress: ":26656",
+			wantErr:         true,
+		},
+		{
+			name:            "external address override without host",
+			externalAddress: "192.168.0.148:26656",
+			options:         []cosmosutil.PeerOption{cosmosutil.WithPeerExternalAddress(":26656")},
+			wantErr:         true,
+		},
+		{
+			name:            "missing node key",
+			externalAddress: "192.168.0.148:26656",
+			withoutNodeKey:  true,
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			configDir := filepath.Join(home, cosmosutil.ChainConfigDir)
+			require.NoError(t, os.MkdirAll(configDir, 0o755))
+
+			config := "[p2p]\nexternal_address = \"" + tt.externalAddress + "\"\n"
+			require.NoError(t, os.WriteFile(filepath.Join(configDir, "config.toml"), []byte(config), 0o644))
+
+			var nodeKey *p2p.NodeKey
+			if !tt.withoutNodeKey {
+				var err error
+				nodeKey, err = p2p.LoadOrGenNodeKey(filepath.Join(configDir, "node_key.json"))
+				require.NoError(t, err)
+			}
+
+			peer, err := cosmosutil.PeerFromNodeConfig(home, tt.options...)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, string(nodeKey.ID())+"@"+tt.wantAddress, peer)
+		})
+	}
+}
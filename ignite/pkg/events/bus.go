@@ -2,6 +2,7 @@ package events
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/ignite/cli/ignite/pkg/cliui/colors"
 )
@@ -16,15 +17,48 @@ type Provider interface {
 	Events() <-chan Event
 }
 
+// SubscriptionPolicy defines how events are delivered to subscribers
+// that are not ready to receive them.
+type SubscriptionPolicy uint8
+
+const (
+	// SubscriptionDrop drops the events that don't fit into the buffer
+	// of a subscriber, so slow subscribers never block the sender.
+	SubscriptionDrop SubscriptionPolicy = iota
+
+	// SubscriptionBlock blocks the sender until every subscriber
+	// has room in its buffer for the event, or the bus is stopped.
+	SubscriptionBlock
+)
+
 type (
 	// Bus defines a bus to send and receive events.
 	Bus struct {
 		evChan  chan Event
 		stopped bool
+		subs    *subscriptions
 	}
 
 	// BusOption configures the Bus.
 	BusOption func(*Bus)
+
+	// subscriptions keeps the channels of the bus subscribers.
+	// It is shared by all the copies of a bus.
+	subscriptions struct {
+		sync.Mutex
+
+		chans      []chan Event
+		bufferSize int
+		policy     SubscriptionPolicy
+		closed     bool
+
+		// done is closed when the subscriptions are closed to
+		// unblock the senders waiting for a subscriber.
+		done chan struct{}
+
+		// sending tracks the events being sent to the subscribers.
+		sending sync.WaitGroup
+	}
 )
 
 // WithBufferSize assigns the size of the buffer to use for buffering events.
@@ -34,10 +68,31 @@ func WithBufferSize(size int) BusOption {
 	}
 }
 
+// WithSubscriptionBufferSize assigns the size of the buffer
+// of each channel returned by Subscribe.
+func WithSubscriptionBufferSize(size int) BusOption {
+	return func(bus *Bus) {
+		bus.subs.bufferSize = size
+	}
+}
+
+// WithSubscriptionPolicy assigns the policy to use when a subscriber
+// buffer is full. By default events are dropped for that subscriber.
+func WithSubscriptionPolicy(policy SubscriptionPolicy) BusOption {
+	return func(bus *Bus) {
+		bus.subs.policy = policy
+	}
+}
+
 // NewBus creates a new event bus.
 func NewBus(options ...BusOption) Bus {
 	bus := Bus{
 		evChan: make(chan Event, DefaultBufferSize),
+		subs: &subscriptions{
+			bufferSize: DefaultBufferSize,
+			policy:     SubscriptionDrop,
+			done:       make(chan struct{}),
+		},
 	}
 
 	for _, apply := range options {
@@ -54,7 +109,9 @@ func (b Bus) Send(message string, options ...Option) {
 		return
 	}
 
-	b.evChan <- New(message, options...)
+	ev := New(message, options...)
+	b.evChan <- ev
+	b.subs.publish(ev)
 }
 
 // Sendf sends a new event with a formatted message to bus.
@@ -84,6 +141,21 @@ func (b Bus) Events() <-chan Event {
 	return b.evChan
 }
 
+// Subscribe returns a new read only channel that receives a copy of each
+// event sent to the bus after the call.
+// Subscribers are added on top of the channel returned by Events,
+// which must still be consumed to avoid blocking the sender.
+// The channel is closed when the bus is stopped.
+func (b Bus) Subscribe() <-chan Event {
+	if b.subs == nil {
+		ch := make(chan Event)
+		close(ch)
+		return ch
+	}
+
+	return b.subs.add()
+}
+
 // Stop stops the event bus.
 // All new events are ignored once the event bus is stopped.
 func (b *Bus) Stop() {
@@ -94,4 +166,77 @@ func (b *Bus) Stop() {
 	b.stopped = true
 
 	close(b.evChan)
+	b.subs.close()
+}
+
+func (s *subscriptions) add() <-chan Event {
+	s.Lock()
+	defer s.Unlock()
+
+	ch := make(chan Event, s.bufferSize)
+	if s.closed {
+		close(ch)
+		return ch
+	}
+
+	s.chans = append(s.chans, ch)
+
+	return ch
+}
+
+func (s *subscriptions) publish(ev Event) {
+	if s == nil {
+		return
+	}
+
+	// The lock is not held while sending so a blocked subscriber
+	// doesn't prevent new subscriptions or stopping the bus.
+	s.Lock()
+	if s.closed {
+		s.Unlock()
+		return
+	}
+	chans := append([]chan Event(nil), s.chans...)
+	s.sending.Add(1)
+	s.Unlock()
+
+	defer s.sending.Done()
+
+	for _, ch := range chans {
+		if s.policy == SubscriptionBlock {
+			select {
+			case ch <- ev:
+			case <-s.done:
+				return
+			}
+			continue
+		}
+
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+func (s *subscriptions) close() {
+	if s == nil {
+		return
+	}
+
+	s.Lock()
+	if s.closed {
+		s.Unlock()
+		return
+	}
+	s.closed = true
+	close(s.done)
+	s.Unlock()
+
+	// Wait for the ongoing sends before closing the channels.
+	s.sending.Wait()
+
+	for _, ch := range s.chans {
+		close(ch)
+	}
 }
@@ -3,6 +3,7 @@ package events_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -209,3 +210,125 @@ func TestBusStop(t *testing.T) {
 	// Assert
 	require.False(t, ok, "expected no events after bus stopped")
 }
+
+func TestBusSubscribe(t *testing.T) {
+	// Arrange
+	bus := events.NewBus()
+	defer bus.Stop()
+
+	sub1 := bus.Subscribe()
+	sub2 := bus.Subscribe()
+
+	// Act
+	bus.Send("test")
+
+	// Assert
+	for _, ch := range []<-chan events.Event{bus.Events(), sub1, sub2} {
+		select {
+		case e := <-ch:
+			require.Equal(t, "test", e.Message)
+		default:
+			t.Error("expected an event to be received")
+		}
+	}
+}
+
+func TestBusSubscribeDrop(t *testing.T) {
+	// Arrange
+	bus := events.NewBus(events.WithSubscriptionBufferSize(1))
+	defer bus.Stop()
+
+	sub := bus.Subscribe()
+
+	// Act
+	bus.Send("first")
+	bus.Send("second")
+
+	// Assert
+	require.Len(t, bus.Events(), 2)
+	require.Len(t, sub, 1)
+	require.Equal(t, "first", (<-sub).Message)
+}
+
+func TestBusSubscribeBlock(t *testing.T) {
+	// Arrange
+	bus := events.NewBus(
+		events.WithSubscriptionBufferSize(0),
+		events.WithSubscriptionPolicy(events.SubscriptionBlock),
+	)
+	defer bus.Stop()
+
+	sub := bus.Subscribe()
+	sent := make(chan struct{})
+
+	// Act
+	go func() {
+		bus.Send("test")
+		close(sent)
+	}()
+
+	// Assert
+	select {
+	case <-sent:
+		t.Fatal("expected sender to block until the subscriber reads")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	require.Equal(t, "test", (<-sub).Message)
+	<-sent
+}
+
+func TestBusStopSubscription(t *testing.T) {
+	// Arrange
+	bus := events.NewBus()
+	sub := bus.Subscribe()
+
+	// Act
+	bus.Stop()
+	_, ok := <-sub
+	_, okAfterStop := <-bus.Subscribe()
+
+	// Assert
+	require.False(t, ok, "expected subscription to be closed")
+	require.False(t, okAfterStop, "expected subscription to be closed after bus stopped")
+}
+
+func TestBusStopBlockedSubscription(t *testing.T) {
+	// Arrange
+	bus := events.NewBus(
+		events.WithSubscriptionBufferSize(0),
+		events.WithSubscriptionPolicy(events.SubscriptionBlock),
+	)
+
+	// The subscriber never reads
+	sub := bus.Subscribe()
+	sender := bus
+	sent := make(chan struct{})
+	go func() {
+		sender.Send("test")
+		close(sent)
+	}()
+
+	// Wait for the sender to block on the subscriber
+	<-bus.Events()
+	time.Sleep(10 * time.Millisecond)
+
+	stopped := make(chan struct{})
+
+	// Act
+	go func() {
+		bus.Stop()
+		close(stopped)
+	}()
+
+	// Assert
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("expected bus to stop while a subscriber is blocked")
+	}
+
+	<-sent
+	_, ok := <-sub
+	require.False(t, ok, "expected subscription to be closed")
+}